@@ -8,6 +8,7 @@ import (
 	"encoding"
 	"encoding/base64"
 	"net/textproto"
+	"time"
 )
 
 type ParamExtractor func(req *http.Request, name string) string
@@ -15,10 +16,83 @@ type ParamExtractor func(req *http.Request, name string) string
 type BindFunc func(req *http.Request, outPtr interface{}) error
 
 var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+var timeType = reflect.TypeOf(time.Time{})
 
-func bind(outValue reflect.Value, values []string) error {
+// bindOptions carries per-field tag metadata needed by bind() beyond the
+// raw values, such as the time.Time parsing tags below. It's computed once
+// per field by bindOptionsFromField rather than being re-derived inside
+// bind() itself.
+type bindOptions struct {
+	TimeFormat   string
+	TimeUTC      bool
+	TimeLocation string
+}
+
+// bindOptionsFromField reads the tags bind() needs off of a struct field.
+func bindOptionsFromField(field reflect.StructField) bindOptions {
+	timeFormat := field.Tag.Get("time_format")
+	if timeFormat == "" {
+		timeFormat = time.RFC3339
+	}
+
+	timeUTC, _ := strconv.ParseBool(field.Tag.Get("time_utc"))
+
+	return bindOptions{
+		TimeFormat:   timeFormat,
+		TimeUTC:      timeUTC,
+		TimeLocation: field.Tag.Get("time_location"),
+	}
+}
+
+func parseTimeValue(value string, opts bindOptions) (time.Time, error) {
+	location := time.Local
+	if opts.TimeLocation != "" {
+		loc, err := time.LoadLocation(opts.TimeLocation)
+		if err != nil {
+			return time.Time{}, err
+		}
+		location = loc
+	}
+
+	t, err := time.ParseInLocation(opts.TimeFormat, value, location)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if opts.TimeUTC {
+		t = t.UTC()
+	}
+
+	return t, nil
+}
+
+func bind(outValue reflect.Value, values []string, opts bindOptions) error {
 	outType := outValue.Type()
 
+	if outType == timeType {
+		if len(values) == 0 || values[0] == "" {
+			return nil
+		}
+		t, err := parseTimeValue(values[0], opts)
+		if err != nil {
+			return err
+		}
+		outValue.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if outType == reflect.PtrTo(timeType) {
+		if len(values) == 0 || values[0] == "" {
+			return nil
+		}
+		t, err := parseTimeValue(values[0], opts)
+		if err != nil {
+			return err
+		}
+		outValue.Set(reflect.ValueOf(&t))
+		return nil
+	}
+
 	if outType.AssignableTo(textUnmarshalerType) {
 		if outType.Kind() != reflect.Ptr {
 			return errors.New("text unmarshaler type is not a pointer")
@@ -47,6 +121,9 @@ func bind(outValue reflect.Value, values []string) error {
 	}
 
 	if outType == reflect.TypeOf((*[]byte)(nil)).Elem() {
+		if len(values) == 0 || values[0] == "" {
+			return nil
+		}
 		valueBytes, err := base64.StdEncoding.DecodeString(values[0])
 		if err != nil {
 			return err
@@ -107,7 +184,7 @@ func bind(outValue reflect.Value, values []string) error {
 			return nil
 		}
 		newValue := reflect.New(outType.Elem())
-		if err := bind(newValue.Elem(), values); err != nil {
+		if err := bind(newValue.Elem(), values, opts); err != nil {
 			return err
 		}
 		outValue.Set(newValue)
@@ -116,7 +193,7 @@ func bind(outValue reflect.Value, values []string) error {
 		sliceValue := reflect.MakeSlice(outType, len(values), len(values))
 		for index, value := range values {
 			elemValue := reflect.New(outType.Elem()).Elem()
-			if err := bind(elemValue, []string{value}); err != nil {
+			if err := bind(elemValue, []string{value}, opts); err != nil {
 				return err
 			}
 			sliceValue.Index(index).Set(elemValue)
@@ -134,18 +211,11 @@ func BindQuery(req *http.Request, outPtr interface{}) error {
 		return errors.New("out is not a pointer")
 	}
 	outValue := outPtrValue.Elem()
-	outType := outValue.Type()
-	for i := 0; i < outType.NumField(); i++ {
-		field := outType.Field(i)
-		queryTag := field.Tag.Get("query")
-		if queryTag == "" {
-			continue
-		}
-
-		urlQuery := req.URL.Query()
-		outFieldValue := outValue.Field(i)
+	plan := defaultStructCache.planFor("query", outValue.Type())
 
-		if err := bind(outFieldValue, urlQuery[queryTag]); err != nil {
+	urlQuery := req.URL.Query()
+	for _, fb := range plan.fields {
+		if err := bind(fieldByIndex(outValue, fb.index), urlQuery[fb.tagName], fb.opts); err != nil {
 			return err
 		}
 	}
@@ -159,17 +229,11 @@ func BindHeader(req *http.Request, outPtr interface{}) error {
 		return errors.New("out is not a pointer")
 	}
 	outValue := outPtrValue.Elem()
-	outType := outValue.Type()
-	for i := 0; i < outType.NumField(); i++ {
-		field := outType.Field(i)
-		headerTag := field.Tag.Get("header")
-		if headerTag == "" {
-			continue
-		}
-
-		outFieldValue := outValue.Field(i)
+	plan := defaultStructCache.planFor("header", outValue.Type())
 
-		if err := bind(outFieldValue, req.Header[textproto.CanonicalMIMEHeaderKey(headerTag)]); err != nil {
+	for _, fb := range plan.fields {
+		values := req.Header[textproto.CanonicalMIMEHeaderKey(fb.tagName)]
+		if err := bind(fieldByIndex(outValue, fb.index), values, fb.opts); err != nil {
 			return err
 		}
 	}
@@ -184,18 +248,11 @@ func BindParam(paramExtractor ParamExtractor) BindFunc {
 			return errors.New("out is not a pointer")
 		}
 		outValue := outPtrValue.Elem()
-		outType := outValue.Type()
-		for i := 0; i < outType.NumField(); i++ {
-			field := outType.Field(i)
-			paramTag := field.Tag.Get("param")
-			if paramTag == "" {
-				continue
-			}
-
-			paramValue := paramExtractor(req, paramTag)
-			outFieldValue := outValue.Field(i)
+		plan := defaultStructCache.planFor("param", outValue.Type())
 
-			if err := bind(outFieldValue, []string{paramValue}); err != nil {
+		for _, fb := range plan.fields {
+			paramValue := paramExtractor(req, fb.tagName)
+			if err := bind(fieldByIndex(outValue, fb.index), []string{paramValue}, fb.opts); err != nil {
 				return err
 			}
 		}
@@ -218,5 +275,6 @@ func Compose(fns ...BindFunc) BindFunc {
 var DefaultBinding = Compose(
 	BindHeader,
 	BindQuery,
+	BindFormBody,
 	BindJSONBody,
 )
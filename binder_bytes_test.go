@@ -0,0 +1,24 @@
+package httpbinder
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindQueryWithMissingBinaryValue(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Data []byte `query:"data"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+	assert.Nil(i.Data)
+}
@@ -351,6 +351,8 @@ func TestBindRequestWithFormBody(t *testing.T) {
 	})
 }
 
+const testMaxMultipartMemory = 32 << 20
+
 func TestBindRequestWithMultipartFormBody(t *testing.T) {
 	assert := require.New(t)
 
@@ -373,7 +375,7 @@ func TestBindRequestWithMultipartFormBody(t *testing.T) {
 	}
 
 	i := &input{}
-	err = DefaultBinding(req, i)
+	err = DefaultBindingWithMultipart(testMaxMultipartMemory)(req, i)
 	assert.Nil(err)
 
 	assert.Equal(i, &input{
@@ -0,0 +1,29 @@
+package httpbinder
+
+import "net/http"
+
+// Binding is a named binder that can populate outPtr from req. It exists
+// alongside the lower-level BindFunc so bindings can be referred to by name
+// (e.g. for logging or content negotiation) and so additional capabilities,
+// such as BindingURI and BindingBody below, can be expressed as optional
+// interfaces a Binding may also implement.
+type Binding interface {
+	Name() string
+	Bind(req *http.Request, outPtr interface{}) error
+}
+
+// BindingURI is implemented by bindings that can populate outPtr directly
+// from a pre-extracted path-parameter map, so callers using routers like
+// chi/gorilla/httprouter can hand in their own parsed params instead of
+// writing a ParamExtractor closure around *http.Request.
+type BindingURI interface {
+	BindURI(params map[string][]string, outPtr interface{}) error
+}
+
+// BindingBody is implemented by bindings that can populate outPtr from a
+// raw body buffer rather than req.Body, so the same body can be bound more
+// than once (e.g. for a validation pass followed by a processing pass)
+// without exhausting the request's body reader.
+type BindingBody interface {
+	BindBody(body []byte, outPtr interface{}) error
+}
@@ -0,0 +1,70 @@
+package httpbinder
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindURI(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		PersonID int64  `uri:"person_id"`
+		Name     string `uri:"name"`
+	}
+
+	params := map[string][]string{
+		"person_id": {"1"},
+		"name":      {"alice"},
+	}
+
+	i := &input{}
+	err := BindURI(params, i)
+	assert.Nil(err)
+	assert.Equal(&input{PersonID: 1, Name: "alice"}, i)
+}
+
+func TestBindURIWith(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Q        string `query:"q"`
+		PersonID int64  `uri:"person_id"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com/people/1?q=a", nil)
+	assert.Nil(err)
+
+	binding := Compose(
+		DefaultBinding,
+		BindURIWith(map[string][]string{"person_id": {"1"}}),
+	)
+
+	i := &input{}
+	err = binding(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{Q: "a", PersonID: 1}, i)
+}
+
+func TestShouldBindBodyWithRebindsSameBody(t *testing.T) {
+	assert := require.New(t)
+
+	type body struct {
+		Foo string `json:"foo"`
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(`{"foo":"bar"}`))
+	assert.Nil(err)
+
+	var first, second body
+	err = ShouldBindBodyWith(req, &first, JSONBinding.(BindingBody))
+	assert.Nil(err)
+	assert.Equal("bar", first.Foo)
+
+	err = ShouldBindBodyWith(req, &second, JSONBinding.(BindingBody))
+	assert.Nil(err)
+	assert.Equal("bar", second.Foo)
+}
@@ -0,0 +1,72 @@
+package httpbinder
+
+import (
+	"net/http"
+)
+
+// BodyBinderRegistry holds an ordered list of BodyDecoder implementations
+// and dispatches an incoming request body to the first one that matches,
+// so additional formats can be supported without rewriting BindJSONBody.
+type BodyBinderRegistry struct {
+	decoders []BodyDecoder
+}
+
+// NewBodyBinderRegistry creates a BodyBinderRegistry seeded with decoders,
+// tried in the given order.
+func NewBodyBinderRegistry(decoders ...BodyDecoder) *BodyBinderRegistry {
+	return &BodyBinderRegistry{
+		decoders: decoders,
+	}
+}
+
+// Register appends a custom BodyDecoder to the registry.
+func (r *BodyBinderRegistry) Register(decoder BodyDecoder) {
+	r.decoders = append(r.decoders, decoder)
+}
+
+// Bind returns a BindFunc that, for each registered decoder in order, calls
+// Match(req) and binds using the first decoder that matches.
+func (r *BodyBinderRegistry) Bind() BindFunc {
+	return func(req *http.Request, outPtr interface{}) error {
+		// skip GET method
+		if req.Method == http.MethodGet {
+			return nil
+		}
+
+		for _, decoder := range r.decoders {
+			if !decoder.Match(req) {
+				continue
+			}
+
+			if req.Body != nil {
+				defer req.Body.Close()
+			}
+
+			return decoder.DecodeBody(req.Body, outPtr)
+		}
+
+		return nil
+	}
+}
+
+// DefaultBodyBinderRegistry is pre-populated with the body decoders shipped
+// by this package, mirroring the MIME set exposed by gin's binding package.
+var DefaultBodyBinderRegistry = NewBodyBinderRegistry(
+	JSONBodyDecoder{},
+	XMLBodyDecoder{},
+	YAMLBodyDecoder{},
+	MsgPackBodyDecoder{},
+	ProtobufBodyDecoder{},
+)
+
+// DefaultBindingWithRegistry is like DefaultBinding, except the request body
+// is dispatched through DefaultBodyBinderRegistry instead of being hard-wired
+// to application/json, so callers can opt into XML/YAML/MsgPack/Protobuf (or
+// register their own BodyDecoder) without giving up the existing behavior of
+// DefaultBinding.
+var DefaultBindingWithRegistry = Compose(
+	BindHeader,
+	BindQuery,
+	BindFormBody,
+	DefaultBodyBinderRegistry.Bind(),
+)
@@ -0,0 +1,118 @@
+package httpbinder
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestYAMLBodyDecoder(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Foo string `yaml:"foo"`
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("foo: bar\n"))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/x-yaml")
+
+	decoder := YAMLBodyDecoder{}
+	assert.True(decoder.Match(req))
+
+	i := &input{}
+	err = decoder.DecodeBody(req.Body, i)
+	assert.Nil(err)
+	assert.Equal("bar", i.Foo)
+}
+
+func TestYAMLBodyDecoderNoMatch(t *testing.T) {
+	assert := require.New(t)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/json")
+
+	assert.False(YAMLBodyDecoder{}.Match(req))
+}
+
+func TestMsgPackBodyDecoder(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Foo string `msgpack:"foo"`
+	}
+
+	bodyBytes, err := msgpack.Marshal(input{Foo: "bar"})
+	assert.Nil(err)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(bodyBytes))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/x-msgpack")
+
+	decoder := MsgPackBodyDecoder{}
+	assert.True(decoder.Match(req))
+
+	i := &input{}
+	err = decoder.DecodeBody(req.Body, i)
+	assert.Nil(err)
+	assert.Equal("bar", i.Foo)
+}
+
+func TestMsgPackBodyDecoderNoMatch(t *testing.T) {
+	assert := require.New(t)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/json")
+
+	assert.False(MsgPackBodyDecoder{}.Match(req))
+}
+
+func TestProtobufBodyDecoder(t *testing.T) {
+	assert := require.New(t)
+
+	message := wrapperspb.String("bar")
+	bodyBytes, err := proto.Marshal(message)
+	assert.Nil(err)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(bodyBytes))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/x-protobuf")
+
+	decoder := ProtobufBodyDecoder{}
+	assert.True(decoder.Match(req))
+
+	out := &wrapperspb.StringValue{}
+	err = decoder.DecodeBody(req.Body, out)
+	assert.Nil(err)
+	assert.Equal("bar", out.GetValue())
+}
+
+func TestProtobufBodyDecoderRejectsNonProtoMessage(t *testing.T) {
+	assert := require.New(t)
+
+	type notAProtoMessage struct {
+		Foo string
+	}
+
+	decoder := ProtobufBodyDecoder{}
+	err := decoder.DecodeBody(strings.NewReader(""), &notAProtoMessage{})
+	assert.Error(err)
+}
+
+func TestProtobufBodyDecoderNoMatch(t *testing.T) {
+	assert := require.New(t)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/json")
+
+	assert.False(ProtobufBodyDecoder{}.Match(req))
+}
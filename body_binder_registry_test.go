@@ -0,0 +1,96 @@
+package httpbinder
+
+import (
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyBinderRegistryWithJSON(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Foo string `json:"foo"`
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(`{"foo":"bar"}`))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/json")
+
+	i := &input{}
+	err = DefaultBodyBinderRegistry.Bind()(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{Foo: "bar"}, i)
+}
+
+func TestBodyBinderRegistryWithXML(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		XMLName xml.Name `xml:"input"`
+		Foo     string   `xml:"foo"`
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(`<input><foo>bar</foo></input>`))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/xml")
+
+	i := &input{}
+	err = DefaultBodyBinderRegistry.Bind()(req, i)
+	assert.Nil(err)
+	assert.Equal("bar", i.Foo)
+}
+
+func TestBodyBinderRegistryNoMatch(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Foo string `json:"foo"`
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(`foo=bar`))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+
+	i := &input{}
+	err = DefaultBodyBinderRegistry.Bind()(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{}, i)
+}
+
+type upperCaseBodyDecoder struct {
+}
+
+func (_ upperCaseBodyDecoder) Match(req *http.Request) bool {
+	return req.Header.Get("content-type") == "application/x-upper"
+}
+
+func (_ upperCaseBodyDecoder) DecodeBody(body io.Reader, out interface{}) error {
+	bodyBytes, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	*out.(*string) = strings.ToUpper(string(bodyBytes))
+	return nil
+}
+
+func TestBodyBinderRegistryWithCustomDecoder(t *testing.T) {
+	assert := require.New(t)
+
+	registry := NewBodyBinderRegistry()
+	registry.Register(upperCaseBodyDecoder{})
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("bar"))
+	assert.Nil(err)
+	req.Header.Set("content-type", "application/x-upper")
+
+	var out string
+	err = registry.Bind()(req, &out)
+	assert.Nil(err)
+	assert.Equal("BAR", out)
+}
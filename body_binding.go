@@ -0,0 +1,81 @@
+package httpbinder
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+)
+
+type contextKey string
+
+const cachedBodyContextKey contextKey = "httpbinder.cachedBody"
+
+// bodyDecoderBinding adapts a BodyDecoder into a named Binding/BindingBody,
+// so the package's built-in decoders can also be used with
+// ShouldBindBodyWith.
+type bodyDecoderBinding struct {
+	name    string
+	decoder BodyDecoder
+}
+
+func (b bodyDecoderBinding) Name() string {
+	return b.name
+}
+
+func (b bodyDecoderBinding) Bind(req *http.Request, outPtr interface{}) error {
+	if req.Method == http.MethodGet {
+		return nil
+	}
+	if !b.decoder.Match(req) {
+		return nil
+	}
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	return b.decoder.DecodeBody(req.Body, outPtr)
+}
+
+func (b bodyDecoderBinding) BindBody(body []byte, outPtr interface{}) error {
+	return b.decoder.DecodeBody(bytes.NewReader(body), outPtr)
+}
+
+var (
+	// JSONBinding is a Binding/BindingBody wrapping JSONBodyDecoder.
+	JSONBinding Binding = bodyDecoderBinding{name: "json", decoder: JSONBodyDecoder{}}
+	// XMLBinding is a Binding/BindingBody wrapping XMLBodyDecoder.
+	XMLBinding Binding = bodyDecoderBinding{name: "xml", decoder: XMLBodyDecoder{}}
+)
+
+// ShouldBindBodyWith reads req.Body once and caches the bytes on req's
+// context, then binds outPtr using binding.BindBody. Calling it again with
+// the same *http.Request (even with a different BindingBody) reuses the
+// cached bytes instead of reading from an already-exhausted body, so the
+// same payload can be bound more than once, e.g. for a validation pass
+// followed by a processing pass.
+func ShouldBindBodyWith(req *http.Request, outPtr interface{}, binding BindingBody) error {
+	body, err := cachedRequestBody(req)
+	if err != nil {
+		return err
+	}
+	return binding.BindBody(body, outPtr)
+}
+
+func cachedRequestBody(req *http.Request) ([]byte, error) {
+	if cached, ok := req.Context().Value(cachedBodyContextKey).([]byte); ok {
+		return cached, nil
+	}
+
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	*req = *req.WithContext(context.WithValue(req.Context(), cachedBodyContextKey, body))
+	return body, nil
+}
@@ -0,0 +1,43 @@
+package httpbinder
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+func BindCookie(req *http.Request, outPtr interface{}) error {
+	outPtrValue := reflect.ValueOf(outPtr)
+	if outPtrValue.Kind() != reflect.Ptr {
+		return errors.New("out is not a pointer")
+	}
+	outValue := outPtrValue.Elem()
+	plan := defaultStructCache.planFor("cookie", outValue.Type())
+
+	cookies := req.Cookies()
+	for _, fb := range plan.fields {
+		var values []string
+		for _, cookie := range cookies {
+			if cookie.Name == fb.tagName {
+				values = append(values, cookie.Value)
+			}
+		}
+
+		if err := bind(fieldByIndex(outValue, fb.index), values, fb.opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DefaultBindingWithCookies is like DefaultBinding, with cookie binding
+// added in, so users can opt in to the `cookie:"..."` tag without changing
+// the behavior of DefaultBinding.
+var DefaultBindingWithCookies = Compose(
+	BindHeader,
+	BindQuery,
+	BindCookie,
+	BindFormBody,
+	BindJSONBody,
+)
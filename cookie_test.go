@@ -0,0 +1,47 @@
+package httpbinder
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindCookie(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		SessionID string   `cookie:"session_id"`
+		Tags      []string `cookie:"tag"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	assert.Nil(err)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "abc123"})
+	req.AddCookie(&http.Cookie{Name: "tag", Value: "a"})
+	req.AddCookie(&http.Cookie{Name: "tag", Value: "b"})
+
+	i := &input{}
+	err = DefaultBindingWithCookies(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{
+		SessionID: "abc123",
+		Tags:      []string{"a", "b"},
+	}, i)
+}
+
+func TestBindCookieMissing(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		SessionID string `cookie:"session_id"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindCookie(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{}, i)
+}
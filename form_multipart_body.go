@@ -1,8 +1,13 @@
 package httpbinder
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"mime"
+	"mime/multipart"
 	"net/http"
+	"reflect"
 )
 
 func BindMultipartFormBody(maxMemory int64) BindFunc {
@@ -20,15 +25,91 @@ func BindMultipartFormBody(maxMemory int64) BindFunc {
 		}
 
 		if err := req.ParseMultipartForm(maxMemory); err != nil {
-			return nil
+			return err
 		}
 
 		if err := bindValues(req.Form, "form", outPtr); err != nil {
 			return err
 		}
 
-		// TODO handle binding file to multipart form
+		return bindMultipartFiles(req.MultipartForm, outPtr)
+	}
+}
+
+// DefaultBindingWithMultipart is like DefaultBinding, with multipart form
+// (including file upload) binding added in via BindMultipartFormBody, so
+// callers can opt in to a maxMemory without changing the behavior of
+// DefaultBinding.
+func DefaultBindingWithMultipart(maxMemory int64) BindFunc {
+	return Compose(
+		BindHeader,
+		BindQuery,
+		BindFormBody,
+		BindMultipartFormBody(maxMemory),
+		BindJSONBody,
+	)
+}
+
+var (
+	fileHeaderType      = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeaderSliceType = reflect.TypeOf([]*multipart.FileHeader(nil))
+	fileType            = reflect.TypeOf((*multipart.File)(nil)).Elem()
+	byteSliceType       = reflect.TypeOf([]byte(nil))
+)
 
-		return nil
+func bindMultipartFiles(form *multipart.Form, outPtr interface{}) error {
+	outPtrValue := reflect.ValueOf(outPtr)
+	if outPtrValue.Kind() != reflect.Ptr {
+		return errors.New("out is not a pointer")
 	}
+	outValue := outPtrValue.Elem()
+	outType := outValue.Type()
+
+	for i := 0; i < outType.NumField(); i++ {
+		field := outType.Field(i)
+		fileTag := field.Tag.Get("file")
+		if fileTag == "" {
+			continue
+		}
+
+		headers := form.File[fileTag]
+		fieldValue := outValue.Field(i)
+
+		switch fieldValue.Type() {
+		case fileHeaderSliceType:
+			fieldValue.Set(reflect.ValueOf(headers))
+		case fileHeaderType:
+			if len(headers) == 0 {
+				return fmt.Errorf("missing required file %q", fileTag)
+			}
+			fieldValue.Set(reflect.ValueOf(headers[0]))
+		case fileType:
+			if len(headers) == 0 {
+				return fmt.Errorf("missing required file %q", fileTag)
+			}
+			file, err := headers[0].Open()
+			if err != nil {
+				return err
+			}
+			fieldValue.Set(reflect.ValueOf(file))
+		case byteSliceType:
+			if len(headers) == 0 {
+				return fmt.Errorf("missing required file %q", fileTag)
+			}
+			file, err := headers[0].Open()
+			if err != nil {
+				return err
+			}
+			fileBytes, err := ioutil.ReadAll(file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+			fieldValue.Set(reflect.ValueOf(fileBytes))
+		default:
+			return fmt.Errorf("unsupported type %s for file field %q", fieldValue.Type(), fileTag)
+		}
+	}
+
+	return nil
 }
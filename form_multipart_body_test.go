@@ -0,0 +1,127 @@
+package httpbinder
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeMultipartFile(writer *multipart.Writer, fieldName, fileName, content string) error {
+	part, err := writer.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return err
+	}
+	_, err = part.Write([]byte(content))
+	return err
+}
+
+func TestBindMultipartFormBodyWithSingleFile(t *testing.T) {
+	assert := require.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(buffer)
+	assert.Nil(writeMultipartFile(writer, "avatar", "avatar.png", "avatar-bytes"))
+	assert.Nil(writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", buffer)
+	assert.Nil(err)
+	req.Header.Set("content-type", writer.FormDataContentType())
+
+	type input struct {
+		Avatar *multipart.FileHeader `file:"avatar"`
+	}
+
+	i := &input{}
+	err = BindMultipartFormBody(32 << 20)(req, i)
+	assert.Nil(err)
+	assert.NotNil(i.Avatar)
+	assert.Equal("avatar.png", i.Avatar.Filename)
+}
+
+func TestBindMultipartFormBodyWithMultiFile(t *testing.T) {
+	assert := require.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(buffer)
+	assert.Nil(writeMultipartFile(writer, "photos", "a.png", "a-bytes"))
+	assert.Nil(writeMultipartFile(writer, "photos", "b.png", "b-bytes"))
+	assert.Nil(writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", buffer)
+	assert.Nil(err)
+	req.Header.Set("content-type", writer.FormDataContentType())
+
+	type input struct {
+		Photos []*multipart.FileHeader `file:"photos"`
+	}
+
+	i := &input{}
+	err = BindMultipartFormBody(32 << 20)(req, i)
+	assert.Nil(err)
+	assert.Len(i.Photos, 2)
+}
+
+func TestBindMultipartFormBodyWithMixedFieldsAndFile(t *testing.T) {
+	assert := require.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(buffer)
+	assert.Nil(writer.WriteField("formField", "bar"))
+	assert.Nil(writeMultipartFile(writer, "attachment", "doc.txt", "doc-bytes"))
+	assert.Nil(writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", buffer)
+	assert.Nil(err)
+	req.Header.Set("content-type", writer.FormDataContentType())
+
+	type input struct {
+		FormField  string `form:"formField"`
+		Attachment []byte `file:"attachment"`
+	}
+
+	i := &input{}
+	err = BindMultipartFormBody(32 << 20)(req, i)
+	assert.Nil(err)
+	assert.Equal("bar", i.FormField)
+	assert.Equal("doc-bytes", string(i.Attachment))
+}
+
+func TestBindMultipartFormBodyWithMissingRequiredFile(t *testing.T) {
+	assert := require.New(t)
+
+	buffer := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(buffer)
+	assert.Nil(writer.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", buffer)
+	assert.Nil(err)
+	req.Header.Set("content-type", writer.FormDataContentType())
+
+	type input struct {
+		Avatar *multipart.FileHeader `file:"avatar"`
+	}
+
+	i := &input{}
+	err = BindMultipartFormBody(32 << 20)(req, i)
+	assert.Error(err)
+}
+
+func TestBindMultipartFormBodyWithMalformedBody(t *testing.T) {
+	assert := require.New(t)
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("not-a-multipart-body"))
+	assert.Nil(err)
+	req.Header.Set("content-type", "multipart/form-data; boundary=missing")
+
+	type input struct {
+		FormField string `form:"formField"`
+	}
+
+	i := &input{}
+	err = BindMultipartFormBody(32 << 20)(req, i)
+	assert.Error(err)
+}
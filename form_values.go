@@ -0,0 +1,27 @@
+package httpbinder
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+)
+
+// bindValues binds a url.Values (as produced by req.Form/req.MultipartForm)
+// onto outPtr's fields tagged with tagKey, reusing the same struct-cache and
+// bind() machinery as BindQuery/BindHeader/BindParam/BindCookie.
+func bindValues(values url.Values, tagKey string, outPtr interface{}) error {
+	outPtrValue := reflect.ValueOf(outPtr)
+	if outPtrValue.Kind() != reflect.Ptr {
+		return errors.New("out is not a pointer")
+	}
+	outValue := outPtrValue.Elem()
+	plan := defaultStructCache.planFor(tagKey, outValue.Type())
+
+	for _, fb := range plan.fields {
+		if err := bind(fieldByIndex(outValue, fb.index), values[fb.tagName], fb.opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package httpbinder
+
+import (
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type MsgPackBodyDecoder struct {
+}
+
+func (_ MsgPackBodyDecoder) Match(req *http.Request) bool {
+	contentType := req.Header.Get("content-type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-msgpack" || mediaType == "application/msgpack"
+}
+
+func (_ MsgPackBodyDecoder) DecodeBody(body io.Reader, out interface{}) error {
+	decoder := msgpack.NewDecoder(body)
+	if err := decoder.Decode(out); err != nil {
+		return err
+	}
+	return nil
+}
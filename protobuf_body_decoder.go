@@ -0,0 +1,37 @@
+package httpbinder
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type ProtobufBodyDecoder struct {
+}
+
+func (_ ProtobufBodyDecoder) Match(req *http.Request) bool {
+	contentType := req.Header.Get("content-type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-protobuf"
+}
+
+func (_ ProtobufBodyDecoder) DecodeBody(body io.Reader, out interface{}) error {
+	message, ok := out.(proto.Message)
+	if !ok {
+		return errors.New("out does not implement proto.Message")
+	}
+
+	bodyBytes, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(bodyBytes, message)
+}
@@ -0,0 +1,114 @@
+package httpbinder
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldBinder describes how to populate one struct field from a named,
+// multi-valued source (query parameters, headers, cookies, ...): its index
+// path into the (possibly embedded) struct, the tag name to look values up
+// by, and the tag-derived bindOptions. It is precomputed once per (tag key,
+// struct type) pair by structCache so the public Bind* functions no longer
+// need to walk NumField()/Tag.Get() on every request.
+type fieldBinder struct {
+	index   []int
+	tagName string
+	opts    bindOptions
+}
+
+// structPlan is the cached field layout for one struct type and tag key.
+type structPlan struct {
+	fields []fieldBinder
+}
+
+// structCache caches structPlans keyed first by tag ("query", "header",
+// "param", "cookie", "form"), then by reflect.Type, so concurrent requests binding
+// the same struct type share one plan instead of re-deriving it.
+type structCache struct {
+	mu    sync.RWMutex
+	plans map[string]map[reflect.Type]*structPlan
+}
+
+func newStructCache() *structCache {
+	return &structCache{
+		plans: map[string]map[reflect.Type]*structPlan{},
+	}
+}
+
+func (c *structCache) planFor(tagKey string, outType reflect.Type) *structPlan {
+	c.mu.RLock()
+	if byType, ok := c.plans[tagKey]; ok {
+		if plan, ok := byType[outType]; ok {
+			c.mu.RUnlock()
+			return plan
+		}
+	}
+	c.mu.RUnlock()
+
+	plan := buildStructPlan(tagKey, outType, nil)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.plans[tagKey] == nil {
+		c.plans[tagKey] = map[reflect.Type]*structPlan{}
+	}
+	c.plans[tagKey][outType] = plan
+	return plan
+}
+
+// buildStructPlan walks outType's fields, recursing into anonymous
+// (embedded) struct fields so that promoted fields are bindable exactly
+// like their own, directly-declared counterparts.
+func buildStructPlan(tagKey string, outType reflect.Type, prefix []int) *structPlan {
+	plan := &structPlan{}
+
+	for i := 0; i < outType.NumField(); i++ {
+		field := outType.Field(i)
+		index := append(append([]int{}, prefix...), i)
+
+		if field.Anonymous {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				nested := buildStructPlan(tagKey, fieldType, index)
+				plan.fields = append(plan.fields, nested.fields...)
+				continue
+			}
+		}
+
+		tagValue := field.Tag.Get(tagKey)
+		if tagValue == "" {
+			continue
+		}
+
+		plan.fields = append(plan.fields, fieldBinder{
+			index:   index,
+			tagName: tagValue,
+			opts:    bindOptionsFromField(field),
+		})
+	}
+
+	return plan
+}
+
+var defaultStructCache = newStructCache()
+
+// fieldByIndex is like reflect.Value.FieldByIndex, except that a nil
+// pointer-typed embedded struct encountered along the path is allocated
+// instead of panicking, since fb.index may descend through the
+// pointer-embedded anonymous fields buildStructPlan recurses into.
+func fieldByIndex(outValue reflect.Value, index []int) reflect.Value {
+	for i, idx := range index {
+		if i > 0 && outValue.Kind() == reflect.Ptr {
+			if outValue.IsNil() {
+				outValue.Set(reflect.New(outValue.Type().Elem()))
+			}
+			outValue = outValue.Elem()
+		}
+		outValue = outValue.Field(idx)
+	}
+	return outValue
+}
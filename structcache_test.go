@@ -0,0 +1,96 @@
+package httpbinder
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindQueryWithNilPointerEmbeddedStruct(t *testing.T) {
+	assert := require.New(t)
+
+	type Pagination struct {
+		Page int `query:"page"`
+	}
+
+	type input struct {
+		*Pagination
+		Q string `query:"q"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?q=a&page=2", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+	assert.NotNil(i.Pagination)
+	assert.Equal(&input{
+		Pagination: &Pagination{Page: 2},
+		Q:          "a",
+	}, i)
+}
+
+func TestBindQueryWithEmbeddedStruct(t *testing.T) {
+	assert := require.New(t)
+
+	type pagination struct {
+		Page int `query:"page"`
+	}
+
+	type input struct {
+		pagination
+		Q string `query:"q"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?q=a&page=2", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+	assert.Equal(&input{
+		pagination: pagination{Page: 2},
+		Q:          "a",
+	}, i)
+}
+
+type benchmarkBindTarget struct {
+	F1  string  `query:"f1"`
+	F2  string  `query:"f2"`
+	F3  string  `query:"f3"`
+	F4  string  `query:"f4"`
+	F5  string  `query:"f5"`
+	F6  int     `query:"f6"`
+	F7  int     `query:"f7"`
+	F8  int     `query:"f8"`
+	F9  int     `query:"f9"`
+	F10 int     `query:"f10"`
+	F11 bool    `query:"f11"`
+	F12 bool    `query:"f12"`
+	F13 float64 `query:"f13"`
+	F14 float64 `query:"f14"`
+	F15 *string `query:"f15"`
+	F16 *string `query:"f16"`
+	F17 string  `query:"f17"`
+	F18 string  `query:"f18"`
+	F19 string  `query:"f19"`
+	F20 string  `query:"f20"`
+}
+
+func BenchmarkBindQuery(b *testing.B) {
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?f1=a&f2=a&f3=a&f4=a&f5=a&f6=1&f7=1&f8=1&f9=1&f10=1&f11=true&f12=true&f13=1.1&f14=1.1&f15=a&f16=a&f17=a&f18=a&f19=a&f20=a", nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		out := &benchmarkBindTarget{}
+		if err := BindQuery(req, out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package httpbinder
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindQueryWithTimeDefaultFormat(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		CreatedAt time.Time `query:"created_at"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?created_at=2021-01-02T15:04:05Z", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+
+	expected, err := time.Parse(time.RFC3339, "2021-01-02T15:04:05Z")
+	assert.Nil(err)
+	assert.True(expected.Equal(i.CreatedAt))
+}
+
+func TestBindQueryWithTimeFormatTag(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Day *time.Time `query:"day" time_format:"2006-01-02"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?day=2021-01-02", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+
+	assert.NotNil(i.Day)
+	assert.Equal(2021, i.Day.Year())
+	assert.Equal(time.Month(1), i.Day.Month())
+	assert.Equal(2, i.Day.Day())
+}
+
+func TestBindQueryWithTimeUTCTag(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		At time.Time `query:"at" time_format:"2006-01-02 15:04:05" time_utc:"1" time_location:"America/New_York"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?at=2021-01-02 10:00:00", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Nil(err)
+	assert.Equal(time.UTC, i.At.Location())
+}
+
+func TestBindQueryWithTimeBadFormat(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		At time.Time `query:"at" time_format:"2006-01-02"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?at=not-a-date", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Error(err)
+}
+
+func TestBindQueryWithTimeBadLocation(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		At time.Time `query:"at" time_format:"2006-01-02" time_location:"Not/ARealZone"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?at=2021-01-02", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = BindQuery(req, i)
+	assert.Error(err)
+}
@@ -0,0 +1,63 @@
+package httpbinder
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+// uriBinding implements Binding and BindingURI, binding the `uri:"..."`
+// struct tag from a pre-extracted path-parameter map.
+type uriBinding struct {
+}
+
+func (uriBinding) Name() string {
+	return "uri"
+}
+
+func (uriBinding) Bind(req *http.Request, outPtr interface{}) error {
+	return errors.New("uri binding requires pre-extracted path parameters; use BindURI or BindURIWith instead")
+}
+
+func (uriBinding) BindURI(params map[string][]string, outPtr interface{}) error {
+	outPtrValue := reflect.ValueOf(outPtr)
+	if outPtrValue.Kind() != reflect.Ptr {
+		return errors.New("out is not a pointer")
+	}
+	outValue := outPtrValue.Elem()
+	outType := outValue.Type()
+	for i := 0; i < outType.NumField(); i++ {
+		field := outType.Field(i)
+		uriTag := field.Tag.Get("uri")
+		if uriTag == "" {
+			continue
+		}
+
+		outFieldValue := outValue.Field(i)
+
+		if err := bind(outFieldValue, params[uriTag], bindOptionsFromField(field)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// URIBinding is the package's Binding/BindingURI implementation for the
+// `uri:"..."` struct tag.
+var URIBinding Binding = uriBinding{}
+
+// BindURI binds path parameters, supplied as a map keyed by parameter name
+// (e.g. from chi's RouteContext or gorilla/mux's Vars), onto outPtr using
+// the `uri:"..."` struct tag.
+func BindURI(params map[string][]string, outPtr interface{}) error {
+	return URIBinding.(BindingURI).BindURI(params, outPtr)
+}
+
+// BindURIWith adapts BindURI into a BindFunc so it can be composed with
+// Compose alongside the package's other binders.
+func BindURIWith(params map[string][]string) BindFunc {
+	return func(req *http.Request, outPtr interface{}) error {
+		return BindURI(params, outPtr)
+	}
+}
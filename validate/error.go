@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"strings"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+// ValidationError wraps validator.ValidationErrors so callers can render
+// field-level messages without importing the validator package directly.
+type ValidationError struct {
+	Errors validator.ValidationErrors
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		messages = append(messages, fieldErr.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Errors
+}
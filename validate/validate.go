@@ -0,0 +1,31 @@
+// Package validate adapts github.com/go-playground/validator/v10 to the
+// httpbinder.Validator interface, so it can be installed via
+// httpbinder.SetValidator to honor `validate:"..."` struct tags as a
+// first-class post-bind step.
+package validate
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+// Validator wraps a validator.Validate instance.
+type Validator struct {
+	validate *validator.Validate
+}
+
+// New creates a Validator backed by a fresh validator.Validate instance.
+func New() *Validator {
+	return &Validator{validate: validator.New()}
+}
+
+// ValidateStruct implements httpbinder.Validator.
+func (v *Validator) ValidateStruct(obj interface{}) error {
+	if err := v.validate.Struct(obj); err != nil {
+		validationErrors, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return err
+		}
+		return &ValidationError{Errors: validationErrors}
+	}
+	return nil
+}
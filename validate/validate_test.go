@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStructWithValidInput(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Email string `validate:"required,email"`
+	}
+
+	err := New().ValidateStruct(&input{Email: "user@example.com"})
+	assert.Nil(err)
+}
+
+func TestValidateStructWithInvalidInput(t *testing.T) {
+	assert := require.New(t)
+
+	type input struct {
+		Email string `validate:"required,email"`
+	}
+
+	err := New().ValidateStruct(&input{Email: "not-an-email"})
+	assert.Error(err)
+
+	var validationErr *ValidationError
+	assert.True(errors.As(err, &validationErr))
+	assert.Len(validationErr.Errors, 1)
+}
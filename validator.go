@@ -0,0 +1,35 @@
+package httpbinder
+
+import "net/http"
+
+// Validator validates a bound struct, returning a descriptive error if it
+// fails validation.
+type Validator interface {
+	ValidateStruct(obj interface{}) error
+}
+
+var defaultValidator Validator
+
+// SetValidator installs v as the package-level Validator used by
+// WithValidator. Passing nil disables validation again.
+func SetValidator(v Validator) {
+	defaultValidator = v
+}
+
+// WithValidator wraps fn so that, once it succeeds, the bound struct is
+// passed to the Validator installed via SetValidator. If no validator has
+// been configured, WithValidator behaves exactly like fn, so it is safe to
+// leave in a Compose pipeline unconditionally.
+func WithValidator(fn BindFunc) BindFunc {
+	return func(req *http.Request, outPtr interface{}) error {
+		if err := fn(req, outPtr); err != nil {
+			return err
+		}
+
+		if defaultValidator == nil {
+			return nil
+		}
+
+		return defaultValidator.ValidateStruct(outPtr)
+	}
+}
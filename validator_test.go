@@ -0,0 +1,50 @@
+package httpbinder
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubValidator struct {
+	err error
+}
+
+func (v stubValidator) ValidateStruct(obj interface{}) error {
+	return v.err
+}
+
+func TestWithValidatorNoneConfigured(t *testing.T) {
+	assert := require.New(t)
+	SetValidator(nil)
+
+	type input struct {
+		Q string `query:"q"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?q=a", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = WithValidator(DefaultBinding)(req, i)
+	assert.Nil(err)
+}
+
+func TestWithValidatorRejects(t *testing.T) {
+	assert := require.New(t)
+	SetValidator(stubValidator{err: errors.New("validation failed")})
+	defer SetValidator(nil)
+
+	type input struct {
+		Q string `query:"q"`
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.com?q=a", nil)
+	assert.Nil(err)
+
+	i := &input{}
+	err = WithValidator(DefaultBinding)(req, i)
+	assert.Error(err)
+}
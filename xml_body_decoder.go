@@ -0,0 +1,28 @@
+package httpbinder
+
+import (
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+)
+
+type XMLBodyDecoder struct {
+}
+
+func (_ XMLBodyDecoder) Match(req *http.Request) bool {
+	contentType := req.Header.Get("content-type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/xml"
+}
+
+func (_ XMLBodyDecoder) DecodeBody(body io.Reader, out interface{}) error {
+	decoder := xml.NewDecoder(body)
+	if err := decoder.Decode(out); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package httpbinder
+
+import (
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"gopkg.in/yaml.v2"
+)
+
+type YAMLBodyDecoder struct {
+}
+
+func (_ YAMLBodyDecoder) Match(req *http.Request) bool {
+	contentType := req.Header.Get("content-type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-yaml" || mediaType == "text/yaml"
+}
+
+func (_ YAMLBodyDecoder) DecodeBody(body io.Reader, out interface{}) error {
+	bodyBytes, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(bodyBytes, out)
+}